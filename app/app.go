@@ -3,6 +3,12 @@ package app
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
 
 	"github.com/integration-system/isp-kit/config"
 	"github.com/integration-system/isp-kit/log"
@@ -10,15 +16,21 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// DefaultShutdownTimeout bounds how long a single Closer.Close is allowed to run during Shutdown.
+const DefaultShutdownTimeout = 10 * time.Second
+
 type Application struct {
 	ctx    context.Context
 	cfg    *config.Config
 	logger *log.Adapter
 
-	cancel  context.CancelFunc
-	group   *errgroup.Group
-	runners []Runner
-	closers []Closer
+	cancel          context.CancelFunc
+	group           *errgroup.Group
+	runners         []Runner
+	closers         []Closer
+	shutdownTimeout time.Duration
+	runDone         chan struct{}
+	runStarted      int32 // atomic; set once Run begins, so Shutdown knows whether to wait on runDone
 }
 
 func New(isDev bool, cfgOpts ...config.Option) (*Application, error) {
@@ -51,15 +63,22 @@ func New(isDev bool, cfgOpts ...config.Option) (*Application, error) {
 	group, ctx := errgroup.WithContext(ctx)
 
 	return &Application{
-		ctx:     ctx,
-		cfg:     cfg,
-		logger:  logger,
-		group:   group,
-		closers: []Closer{logger},
-		cancel:  cancel,
+		ctx:             ctx,
+		cfg:             cfg,
+		logger:          logger,
+		group:           group,
+		closers:         []Closer{logger},
+		cancel:          cancel,
+		shutdownTimeout: DefaultShutdownTimeout,
+		runDone:         make(chan struct{}),
 	}, nil
 }
 
+// SetShutdownTimeout overrides DefaultShutdownTimeout, the per-closer deadline applied by Shutdown.
+func (a *Application) SetShutdownTimeout(timeout time.Duration) {
+	a.shutdownTimeout = timeout
+}
+
 func (a Application) Context() context.Context {
 	return a.ctx
 }
@@ -72,6 +91,12 @@ func (a Application) Logger() *log.Adapter {
 	return a.logger
 }
 
+// SlogLogger returns a *slog.Logger backed by the application's logger, so it can be passed directly
+// into libraries accepting the standard library's logging interface (OTel, pgx, etc.) without boilerplate.
+func (a Application) SlogLogger() *slog.Logger {
+	return slog.New(log.NewSlogHandler(a.logger))
+}
+
 func (a *Application) AddRunners(runners ...Runner) {
 	a.runners = append(a.runners, runners...)
 }
@@ -80,7 +105,13 @@ func (a *Application) AddClosers(closers ...Closer) {
 	a.closers = append(a.closers, closers...)
 }
 
+// Run blocks until every added Runner returns, then reports the aggregate error. Shutdown waits for Run to
+// finish (via runDone) before closing any closers, so a runner that logs on its way out never races a
+// closer - including the logger - being closed.
 func (a *Application) Run() error {
+	atomic.StoreInt32(&a.runStarted, 1)
+	defer close(a.runDone)
+
 	for i := range a.runners {
 		runner := a.runners[i]
 		a.group.Go(func() error {
@@ -91,17 +122,106 @@ func (a *Application) Run() error {
 			return nil
 		})
 	}
-	return a.group.Wait()
+	err := a.group.Wait()
+	a.logger.Info(a.ctx, "runner exited", log.Any("error", err))
+	return err
 }
 
+// RunWithSignals behaves like Run, but also installs a signal handler: the first received signal
+// triggers a graceful Shutdown, the second force-exits the process via os.Exit(1). If signals is empty,
+// os.Interrupt and syscall.SIGTERM are used. Shutdown's closer chain runs no matter how Run returned -
+// including a Runner finishing or failing on its own, with no signal ever received - so a closer like
+// tracing.Closer always gets a chance to flush.
+func (a *Application) RunWithSignals(ctx context.Context, signals ...os.Signal) error {
+	if len(signals) == 0 {
+		signals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+	}
+
+	notifyCtx, stop := signal.NotifyContext(ctx, signals...)
+	defer stop()
+
+	errCh := make(chan error, 1)
+	atomic.StoreInt32(&a.runStarted, 1)
+	go func() {
+		errCh <- a.Run()
+	}()
+
+	select {
+	case err := <-errCh:
+		a.Shutdown()
+		return err
+	case <-notifyCtx.Done():
+	}
+
+	forceCh := make(chan os.Signal, 1)
+	signal.Notify(forceCh, signals...)
+	defer signal.Stop(forceCh)
+
+	shutdownDone := make(chan struct{})
+	go func() {
+		a.Shutdown()
+		close(shutdownDone)
+	}()
+
+	select {
+	case <-shutdownDone:
+		return <-errCh
+	case <-forceCh:
+		a.logger.Error(a.ctx, errors.New("second shutdown signal received, forcing exit"))
+		os.Exit(1)
+		return nil
+	}
+}
+
+// ContextCloser is an optional extension of Closer: a Closer that also implements ContextCloser has the
+// per-closer deadline from Shutdown passed down, so it can abort in-flight work instead of blocking past it.
+type ContextCloser interface {
+	CloseContext(ctx context.Context) error
+}
+
+// Shutdown cancels the application context and closes every added Closer in reverse order, so the logger
+// - always closers[0], set in New - is the last thing closed and every other closer's "closing X"/timeout/
+// error log lines still reach it. If Run was started, Shutdown waits for it to return before touching
+// a.closers, so a runner that logs on its way out never races a closer being closed; if Run was never
+// called (e.g. a caller invoking Shutdown directly after a failed setup step), that wait is skipped
+// instead of blocking forever.
 func (a *Application) Shutdown() {
 	a.cancel()
+	if atomic.LoadInt32(&a.runStarted) == 1 {
+		<-a.runDone
+	}
 
-	for i := 0; i < len(a.closers); i++ {
+	for i := len(a.closers) - 1; i >= 0; i-- {
 		closer := a.closers[i]
-		err := closer.Close()
-		if err != nil {
-			a.logger.Error(a.ctx, err, log.String("closer", fmt.Sprintf("%T", closer)))
+		name := fmt.Sprintf("%T", closer)
+		a.logger.Info(a.ctx, "closing "+name)
+
+		closeCtx, cancel := context.WithTimeout(context.Background(), a.shutdownTimeout)
+		err := a.closeOne(closeCtx, closer)
+		cancel()
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			a.logger.Error(a.ctx, errors.WithMessagef(err, "closer[%s] timed out", name))
+		} else if err != nil {
+			a.logger.Error(a.ctx, err, log.String("closer", name))
+		}
+	}
+}
+
+func (a *Application) closeOne(ctx context.Context, closer Closer) error {
+	done := make(chan error, 1)
+	go func() {
+		if cc, ok := closer.(ContextCloser); ok {
+			done <- cc.CloseContext(ctx)
+		} else {
+			done <- closer.Close()
 		}
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/integration-system/isp-kit/log"
+	"golang.org/x/sync/errgroup"
+)
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
+
+type runnerFunc func(ctx context.Context) error
+
+func (f runnerFunc) Run(ctx context.Context) error {
+	return f(ctx)
+}
+
+func newTestLogger(t *testing.T) *log.Adapter {
+	t.Helper()
+	logger, err := log.New(log.WithDevelopmentMode())
+	if err != nil {
+		t.Fatalf("new logger: %v", err)
+	}
+	return logger
+}
+
+func TestShutdown_ClosesInReverseOrder(t *testing.T) {
+	var closedOrder []string
+	record := func(name string) Closer {
+		return closerFunc(func() error {
+			closedOrder = append(closedOrder, name)
+			return nil
+		})
+	}
+
+	runDone := make(chan struct{})
+	close(runDone)
+
+	a := &Application{
+		ctx:             context.Background(),
+		cancel:          func() {},
+		logger:          newTestLogger(t),
+		shutdownTimeout: time.Second,
+		runDone:         runDone,
+		runStarted:      1,
+		closers:         []Closer{record("logger"), record("first"), record("second")},
+	}
+
+	a.Shutdown()
+
+	want := []string{"second", "first", "logger"}
+	if !reflect.DeepEqual(closedOrder, want) {
+		t.Fatalf("closed order = %v, want %v", closedOrder, want)
+	}
+}
+
+func TestShutdown_ToleratesRunNeverCalled(t *testing.T) {
+	a := &Application{
+		ctx:             context.Background(),
+		cancel:          func() {},
+		logger:          newTestLogger(t),
+		shutdownTimeout: time.Second,
+		runDone:         make(chan struct{}),
+		closers:         []Closer{},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		a.Shutdown()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Shutdown deadlocked waiting for a Run that was never called")
+	}
+}
+
+func TestRunWithSignals_RunsClosersWhenRunReturnsWithoutSignal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	group, ctx := errgroup.WithContext(ctx)
+
+	closed := false
+	a := &Application{
+		ctx:             ctx,
+		cancel:          cancel,
+		group:           group,
+		logger:          newTestLogger(t),
+		shutdownTimeout: time.Second,
+		runDone:         make(chan struct{}),
+		closers: []Closer{closerFunc(func() error {
+			closed = true
+			return nil
+		})},
+	}
+	a.AddRunners(runnerFunc(func(context.Context) error {
+		return nil
+	}))
+
+	err := a.RunWithSignals(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !closed {
+		t.Fatal("expected closers to run when Run returns on its own, without a signal")
+	}
+}
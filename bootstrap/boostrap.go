@@ -1,7 +1,9 @@
 package bootstrap
 
 import (
+	"context"
 	json2 "encoding/json"
+	"fmt"
 	"log"
 	"net"
 	"os"
@@ -13,6 +15,9 @@ import (
 	"github.com/integration-system/isp-kit/cluster"
 	"github.com/integration-system/isp-kit/config"
 	"github.com/integration-system/isp-kit/json"
+	kitlog "github.com/integration-system/isp-kit/log"
+	"github.com/integration-system/isp-kit/observability/dynamic"
+	"github.com/integration-system/isp-kit/observability/tracing"
 	"github.com/integration-system/isp-kit/rc"
 	"github.com/integration-system/isp-kit/rc/schema"
 	"github.com/integration-system/isp-kit/validator"
@@ -20,19 +25,20 @@ import (
 )
 
 type Bootstrap struct {
-	App            *app.Application
-	ClusterCli     *cluster.Client
-	RemoteConfig   *rc.Config
-	BindingAddress string
-	MigrationsDir  string
-	ModuleName     string
+	App             *app.Application
+	ClusterCli      *cluster.Client
+	RemoteConfig    *rc.Config
+	TracingProvider tracing.Provider
+	BindingAddress  string
+	MigrationsDir   string
+	ModuleName      string
 }
 
 func New(moduleVersion string, remoteConfig interface{}, endpoints []cluster.EndpointDescriptor) *Bootstrap {
 	isDev := strings.ToLower(os.Getenv("APP_MODE")) == "dev"
-	localConfigPath, err := configFilePath(isDev)
+	localConfigPath, configSource, err := resolveLocalConfig(isDev)
 	if err != nil {
-		log.Fatal(errors.WithMessage(err, "resolve local config path"))
+		log.Fatal(errors.WithMessage(err, "resolve local config"))
 		return nil
 	}
 	app, err := app.New(
@@ -50,9 +56,90 @@ func New(moduleVersion string, remoteConfig interface{}, endpoints []cluster.End
 		app.Logger().Fatal(app.Context(), err)
 	}
 
+	watchLocalConfig(app.Context(), app.Logger(), configSource, localConfigPath)
+
 	return boot
 }
 
+// resolveLocalConfig picks the ConfigSource to read local config from: APP_CONFIG_SOURCE (e.g.
+// "http://config.example.com/module.yml") when set, otherwise the default file source pointed at
+// config_dev.yml/config.yml. It returns a path that config.WithReadingFromFile can read, materializing
+// non-file sources into a local cache file first so the rest of the config pipeline is unaffected.
+func resolveLocalConfig(isDev bool) (string, ConfigSource, error) {
+	sourceURL := os.Getenv("APP_CONFIG_SOURCE")
+	if sourceURL == "" {
+		path, err := configFilePath(isDev)
+		if err != nil {
+			return "", nil, errors.WithMessage(err, "resolve local config path")
+		}
+		return path, NewFileConfigSource(path), nil
+	}
+
+	source, err := NewConfigSource(sourceURL)
+	if err != nil {
+		return "", nil, errors.WithMessage(err, "new config source")
+	}
+
+	// Namespaced with the PID: this cache is private to the current process (only this process ever reads
+	// it back, via config.WithReadingFromFile below), so a fixed name would let two module instances on the
+	// same host stomp each other's cached document.
+	cachePath := path.Join(os.TempDir(), fmt.Sprintf("isp-kit-local-config-cache-%d.yml", os.Getpid()))
+	data, err := source.Load(context.Background())
+	if err != nil {
+		return "", nil, errors.WithMessage(err, "load config")
+	}
+	err = os.WriteFile(cachePath, data, 0o600)
+	if err != nil {
+		return "", nil, errors.WithMessage(err, "cache loaded config")
+	}
+
+	return cachePath, source, nil
+}
+
+// watchLocalConfig does NOT hot-reload a running module. It keeps the on-disk config cache in sync
+// whenever a non-file configSource observes a change, and eagerly re-validates the new document by
+// running it through the same config.New pipeline used at startup, so a bad document is caught and
+// logged immediately instead of silently waiting for the next restart to fail.
+//
+// Scoped down deliberately: every LocalConfig field that bootstrap() reads (GrpcInnerAddress/
+// GrpcOuterAddress for the already-bound listener, ModuleName/ConfigServiceAddress for the already-
+// registered cluster client, RemoteConfigOverride for the already-constructed rc.Config, ...) is consumed
+// once, at startup, into state a running process can't safely swap out from under itself - so there is no
+// subset of LocalConfig this function can apply live. Actually picking up a change still requires
+// restarting the process; this function only shortens the feedback loop on a bad document and makes sure
+// the next restart reads the latest one.
+//
+// It also deliberately never touches rc.Config: LocalConfig and a module's remote config are unrelated
+// schemas sharing nothing but the rc.Config revalidation path, and feeding one into the other spams
+// validation errors at best and corrupts whichever document shows up last at worst. FileConfigSource.Watch
+// never fires, so this is a no-op for the default dev workflow.
+func watchLocalConfig(ctx context.Context, logger *kitlog.Adapter, configSource ConfigSource, cachePath string) {
+	if _, ok := configSource.(FileConfigSource); ok {
+		return
+	}
+
+	go func() {
+		err := configSource.Watch(ctx, func(data []byte) {
+			err := os.WriteFile(cachePath, data, 0o600)
+			if err != nil {
+				logger.Error(ctx, errors.WithMessage(err, "cache reloaded config"))
+				return
+			}
+
+			_, err = config.New(config.WithValidator(validator.Default), config.WithReadingFromFile(cachePath))
+			if err != nil {
+				logger.Error(ctx, errors.WithMessage(err, "validate reloaded local config"))
+				return
+			}
+
+			logger.Info(ctx, "local config source changed: cache refreshed and validated, restart the process to apply it", kitlog.String("path", cachePath))
+		})
+		if err != nil {
+			logger.Error(ctx, errors.WithMessage(err, "watch config source"))
+		}
+	}()
+}
+
 func bootstrap(isDev bool, app *app.Application, moduleVersion string, remoteConfig interface{}, endpoints []cluster.EndpointDescriptor) (*Bootstrap, error) {
 	localConfig := LocalConfig{}
 	err := app.Config().Read(&localConfig)
@@ -117,13 +204,26 @@ func bootstrap(isDev bool, app *app.Application, moduleVersion string, remoteCon
 		return nil, errors.WithMessage(err, "resolve migrations dir path")
 	}
 
+	tracingProvider, sampler, err := tracing.NewProviderFromConfiguration(app.Context(), tracingConfigFromEnv(localConfig.ModuleName, moduleVersion))
+	if err != nil {
+		return nil, errors.WithMessage(err, "new tracing provider")
+	}
+	app.AddClosers(tracing.NewCloser(tracingProvider))
+
+	// No *http_metrics.ServerStorage is built here - bootstrap has no opinion on a module's HTTP metrics
+	// registry - so the cardinality limit field of the observability block is accepted but has nothing to
+	// act on yet; log level and trace sample ratio are live from the start.
+	reconfigurator := dynamic.New(app.Logger(), app.Logger(), sampler, nil)
+	reconfigurator.WatchRemoteConfig(app.Context(), rc)
+
 	return &Bootstrap{
-		App:            app,
-		ClusterCli:     clusterCli,
-		RemoteConfig:   rc,
-		BindingAddress: bindingAddress,
-		ModuleName:     localConfig.ModuleName,
-		MigrationsDir:  migrationsDir,
+		App:             app,
+		ClusterCli:      clusterCli,
+		RemoteConfig:    rc,
+		TracingProvider: tracingProvider,
+		BindingAddress:  bindingAddress,
+		ModuleName:      localConfig.ModuleName,
+		MigrationsDir:   migrationsDir,
 	}, nil
 }
 
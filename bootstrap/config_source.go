@@ -0,0 +1,391 @@
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ConfigSource abstracts where bootstrap reads its local configuration bytes from, and optionally how it
+// watches for changes so they can be picked up without baking a fresh config into the image. The
+// file-based source (the historical behavior of configFilePath) is the default; NewConfigSource also
+// builds env-only and HTTP-polling sources, selectable at runtime via APP_CONFIG_SOURCE.
+type ConfigSource interface {
+	Load(ctx context.Context) ([]byte, error)
+	// Watch calls onChange whenever the source observes new configuration bytes. Sources that can't watch
+	// for changes (e.g. FileConfigSource) block until ctx is done and return nil.
+	Watch(ctx context.Context, onChange func([]byte)) error
+}
+
+// NewConfigSource parses an APP_CONFIG_SOURCE-style URL and returns the matching ConfigSource.
+// Supported schemes: "file" (default, path-only config file), "env" (config taken verbatim from an env
+// var), "http"/"https" (polled with ETag-based change detection), "consul" (e.g.
+// "consul://host:8500/path/to/key", read via Consul's HTTP KV API and blocking queries) and "etcd" (e.g.
+// "etcd://host:2379/path/to/key", read via etcd's v3 JSON gRPC-gateway and polled for changes).
+func NewConfigSource(sourceURL string) (ConfigSource, error) {
+	u, err := url.Parse(sourceURL)
+	if err != nil {
+		return nil, errors.WithMessage(err, "parse config source url")
+	}
+
+	switch u.Scheme {
+	case "", "file":
+		return NewFileConfigSource(u.Opaque + u.Path), nil
+	case "env":
+		return NewEnvConfigSource(u.Host), nil
+	case "http", "https":
+		return NewHttpConfigSource(sourceURL, 0), nil
+	case "consul":
+		return NewConsulConfigSource(u.Host, strings.TrimPrefix(u.Path, "/")), nil
+	case "etcd":
+		return NewEtcdConfigSource(u.Host, strings.TrimPrefix(u.Path, "/"), 0), nil
+	default:
+		return nil, errors.Errorf("unknown config source scheme: %s", u.Scheme)
+	}
+}
+
+// FileConfigSource reads configuration from a local file. It's the default source and has no Watch
+// support of its own; config is re-read from disk on every process restart, same as before.
+type FileConfigSource struct {
+	path string
+}
+
+func NewFileConfigSource(path string) FileConfigSource {
+	return FileConfigSource{path: path}
+}
+
+func (s FileConfigSource) Load(_ context.Context) ([]byte, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, errors.WithMessage(err, "read file")
+	}
+	return data, nil
+}
+
+func (s FileConfigSource) Watch(ctx context.Context, _ func([]byte)) error {
+	<-ctx.Done()
+	return nil
+}
+
+// EnvConfigSource takes the whole configuration verbatim from a single environment variable.
+type EnvConfigSource struct {
+	envVar string
+}
+
+func NewEnvConfigSource(envVar string) EnvConfigSource {
+	return EnvConfigSource{envVar: envVar}
+}
+
+func (s EnvConfigSource) Load(_ context.Context) ([]byte, error) {
+	data, ok := os.LookupEnv(s.envVar)
+	if !ok {
+		return nil, errors.Errorf("env var %s is not set", s.envVar)
+	}
+	return []byte(data), nil
+}
+
+func (s EnvConfigSource) Watch(ctx context.Context, _ func([]byte)) error {
+	<-ctx.Done()
+	return nil
+}
+
+const defaultHttpPollInterval = 15 * time.Second
+
+// HttpConfigSource pulls configuration from an HTTP endpoint and polls it for changes, skipping
+// re-delivery when the endpoint responds with a matching ETag.
+type HttpConfigSource struct {
+	url          string
+	pollInterval time.Duration
+	client       *http.Client
+}
+
+// NewHttpConfigSource builds an HttpConfigSource. A zero pollInterval uses defaultHttpPollInterval.
+func NewHttpConfigSource(url string, pollInterval time.Duration) HttpConfigSource {
+	if pollInterval <= 0 {
+		pollInterval = defaultHttpPollInterval
+	}
+	return HttpConfigSource{
+		url:          url,
+		pollInterval: pollInterval,
+		client:       &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s HttpConfigSource) Load(ctx context.Context) ([]byte, error) {
+	data, _, err := s.fetch(ctx, "")
+	return data, err
+}
+
+// Watch primes its change-detection state from an initial fetch, mirroring ConsulConfigSource's
+// index != 0 guard, so the first poll tick doesn't treat "haven't seen an ETag yet" as "changed".
+func (s HttpConfigSource) Watch(ctx context.Context, onChange func([]byte)) error {
+	etag := ""
+	if _, primed, err := s.fetch(ctx, ""); err == nil {
+		etag = primed
+	}
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			data, newEtag, err := s.fetch(ctx, etag)
+			if err != nil {
+				continue
+			}
+			if data == nil {
+				continue // 304 Not Modified
+			}
+			etag = newEtag
+			onChange(data)
+		}
+	}
+}
+
+// fetch returns (nil, etag, nil) when the server responds 304 Not Modified for the given etag.
+func (s HttpConfigSource) fetch(ctx context.Context, etag string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, "", errors.WithMessage(err, "new request")
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, "", errors.WithMessage(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, etag, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", errors.WithMessage(err, "read body")
+	}
+	return data, resp.Header.Get("ETag"), nil
+}
+
+const (
+	consulBlockingWait = 5 * time.Minute
+	consulRetryBackoff = 5 * time.Second
+)
+
+// ConsulConfigSource reads configuration from a single key in Consul's KV store over its plain HTTP API -
+// no consul client dependency needed - and watches for changes using Consul's blocking queries: a GET the
+// server holds open until the key's ModifyIndex changes or the wait period elapses.
+type ConsulConfigSource struct {
+	address string
+	key     string
+	client  *http.Client
+}
+
+// NewConsulConfigSource builds a ConsulConfigSource reading key from the Consul agent/cluster at address
+// (e.g. "consul.example.com:8500").
+func NewConsulConfigSource(address string, key string) ConsulConfigSource {
+	return ConsulConfigSource{
+		address: address,
+		key:     key,
+		client:  &http.Client{},
+	}
+}
+
+func (s ConsulConfigSource) Load(ctx context.Context) ([]byte, error) {
+	data, _, err := s.fetch(ctx, 0, 0)
+	return data, err
+}
+
+func (s ConsulConfigSource) Watch(ctx context.Context, onChange func([]byte)) error {
+	index := uint64(0)
+	for {
+		data, newIndex, err := s.fetch(ctx, index, consulBlockingWait)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(consulRetryBackoff):
+			}
+			continue
+		}
+		if index != 0 && newIndex != index {
+			onChange(data)
+		}
+		index = newIndex
+	}
+}
+
+// fetch issues a single Consul KV GET for s.key, as a blocking query against index when wait > 0, and
+// returns the raw value together with the response's X-Consul-Index.
+func (s ConsulConfigSource) fetch(ctx context.Context, index uint64, wait time.Duration) ([]byte, uint64, error) {
+	reqURL := url.URL{Scheme: "http", Host: s.address, Path: "/v1/kv/" + s.key}
+	q := reqURL.Query()
+	q.Set("raw", "")
+	if index > 0 {
+		q.Set("index", strconv.FormatUint(index, 10))
+	}
+	if wait > 0 {
+		q.Set("wait", wait.String())
+	}
+	reqURL.RawQuery = q.Encode()
+
+	reqCtx := ctx
+	if wait > 0 {
+		var cancel context.CancelFunc
+		reqCtx, cancel = context.WithTimeout(ctx, wait+10*time.Second)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		return nil, 0, errors.WithMessage(err, "new request")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, errors.WithMessage(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, errors.WithMessage(err, "read body")
+	}
+
+	newIndex, err := strconv.ParseUint(resp.Header.Get("X-Consul-Index"), 10, 64)
+	if err != nil {
+		return nil, 0, errors.WithMessage(err, "parse X-Consul-Index")
+	}
+
+	return data, newIndex, nil
+}
+
+const defaultEtcdPollInterval = 15 * time.Second
+
+// EtcdConfigSource reads configuration from a single key via etcd's v3 JSON gRPC-gateway HTTP API
+// (POST /v3/kv/range) and polls for changes. This trades the immediacy of etcd's native streaming watch
+// for staying dependency-free, the same tradeoff HttpConfigSource makes.
+type EtcdConfigSource struct {
+	address      string
+	key          string
+	pollInterval time.Duration
+	client       *http.Client
+}
+
+// NewEtcdConfigSource builds an EtcdConfigSource reading key from the etcd gRPC-gateway at address (e.g.
+// "etcd.example.com:2379"). A zero pollInterval uses defaultEtcdPollInterval.
+func NewEtcdConfigSource(address string, key string, pollInterval time.Duration) EtcdConfigSource {
+	if pollInterval <= 0 {
+		pollInterval = defaultEtcdPollInterval
+	}
+	return EtcdConfigSource{
+		address:      address,
+		key:          key,
+		pollInterval: pollInterval,
+		client:       &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s EtcdConfigSource) Load(ctx context.Context) ([]byte, error) {
+	data, _, err := s.fetch(ctx)
+	return data, err
+}
+
+// Watch primes lastRevision from an initial fetch, mirroring ConsulConfigSource's index != 0 guard, so
+// the first poll tick doesn't treat "haven't seen a revision yet" as "changed".
+func (s EtcdConfigSource) Watch(ctx context.Context, onChange func([]byte)) error {
+	lastRevision := ""
+	if _, revision, err := s.fetch(ctx); err == nil {
+		lastRevision = revision
+	}
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			data, revision, err := s.fetch(ctx)
+			if err != nil {
+				continue
+			}
+			if revision == lastRevision {
+				continue
+			}
+			lastRevision = revision
+			onChange(data)
+		}
+	}
+}
+
+type etcdRangeResponse struct {
+	Kvs []struct {
+		Value       string `json:"value"`
+		ModRevision string `json:"mod_revision"`
+	} `json:"kvs"`
+}
+
+// fetch issues a single etcd v3 Range request for s.key and returns its value and mod_revision.
+func (s EtcdConfigSource) fetch(ctx context.Context) ([]byte, string, error) {
+	reqBody, err := json.Marshal(map[string]string{"key": base64.StdEncoding.EncodeToString([]byte(s.key))})
+	if err != nil {
+		return nil, "", errors.WithMessage(err, "marshal request")
+	}
+
+	reqURL := url.URL{Scheme: "http", Host: s.address, Path: "/v3/kv/range"}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL.String(), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, "", errors.WithMessage(err, "new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, "", errors.WithMessage(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	rangeResp := etcdRangeResponse{}
+	err = json.NewDecoder(resp.Body).Decode(&rangeResp)
+	if err != nil {
+		return nil, "", errors.WithMessage(err, "decode response")
+	}
+	if len(rangeResp.Kvs) == 0 {
+		return nil, "", errors.Errorf("key %q not found", s.key)
+	}
+
+	value, err := base64.StdEncoding.DecodeString(rangeResp.Kvs[0].Value)
+	if err != nil {
+		return nil, "", errors.WithMessage(err, "decode value")
+	}
+
+	return value, rangeResp.Kvs[0].ModRevision, nil
+}
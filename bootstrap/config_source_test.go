@@ -0,0 +1,152 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewConfigSource_SchemeDispatch(t *testing.T) {
+	cases := []struct {
+		url  string
+		want interface{}
+	}{
+		{"", FileConfigSource{}},
+		{"./config.yml", FileConfigSource{}},
+		{"env://APP_CONFIG", EnvConfigSource{}},
+		{"http://example.com/config.yml", HttpConfigSource{}},
+		{"https://example.com/config.yml", HttpConfigSource{}},
+		{"consul://consul.example.com:8500/module/config", ConsulConfigSource{}},
+		{"etcd://etcd.example.com:2379/module/config", EtcdConfigSource{}},
+	}
+
+	for _, tc := range cases {
+		source, err := NewConfigSource(tc.url)
+		if err != nil {
+			t.Fatalf("NewConfigSource(%q): %v", tc.url, err)
+		}
+		gotType := fmt.Sprintf("%T", source)
+		wantType := fmt.Sprintf("%T", tc.want)
+		if gotType != wantType {
+			t.Fatalf("NewConfigSource(%q) = %s, want %s", tc.url, gotType, wantType)
+		}
+	}
+}
+
+func TestNewConfigSource_UnknownScheme(t *testing.T) {
+	_, err := NewConfigSource("ftp://example.com/config.yml")
+	if err == nil {
+		t.Fatal("expected an error for an unknown scheme")
+	}
+}
+
+func TestHttpConfigSource_LoadAndWatch(t *testing.T) {
+	etag := `"v1"`
+	body := []byte("grpcInnerAddress:\n  port: 8080\n")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	source := NewHttpConfigSource(srv.URL, 10*time.Millisecond)
+
+	data, err := source.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if string(data) != string(body) {
+		t.Fatalf("Load() = %q, want %q", data, body)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	changes := make(chan []byte, 1)
+	err = source.Watch(ctx, func(data []byte) {
+		select {
+		case changes <- data:
+		default:
+		}
+	})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+
+	select {
+	case got := <-changes:
+		t.Fatalf("unexpected change notified for an unchanged ETag: %q", got)
+	default:
+	}
+}
+
+func TestConsulConfigSource_Load(t *testing.T) {
+	value := []byte("moduleName: demo\n")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/kv/module/config" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("X-Consul-Index", "42")
+		w.Write(value)
+	}))
+	defer srv.Close()
+
+	source := NewConsulConfigSource(srv.Listener.Addr().String(), "module/config")
+
+	data, err := source.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if string(data) != string(value) {
+		t.Fatalf("Load() = %q, want %q", data, value)
+	}
+}
+
+func TestEtcdConfigSource_Load(t *testing.T) {
+	value := []byte("moduleName: demo\n")
+	encoded := base64.StdEncoding.EncodeToString(value)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/kv/range" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"kvs":[{"value":%q,"mod_revision":"7"}]}`, encoded)
+	}))
+	defer srv.Close()
+
+	source := NewEtcdConfigSource(srv.Listener.Addr().String(), "module/config", time.Second)
+
+	data, err := source.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if string(data) != string(value) {
+		t.Fatalf("Load() = %q, want %q", data, value)
+	}
+}
+
+func TestEtcdConfigSource_KeyNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"kvs":[]}`))
+	}))
+	defer srv.Close()
+
+	source := NewEtcdConfigSource(srv.Listener.Addr().String(), "missing/key", time.Second)
+
+	_, err := source.Load(context.Background())
+	if err == nil {
+		t.Fatal("expected an error for a missing key")
+	}
+}
@@ -0,0 +1,34 @@
+package bootstrap
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/integration-system/isp-kit/observability/tracing"
+)
+
+// tracingConfigFromEnv builds a tracing.Config from TRACING_* environment variables, the same
+// env-override convention as APP_MODE/APP_CONFIG_PATH. Tracing stays disabled (tracing.NewNoopProvider)
+// unless TRACING_ENABLE is set to "true".
+func tracingConfigFromEnv(moduleName string, moduleVersion string) tracing.Config {
+	ratio, _ := strconv.ParseFloat(os.Getenv("TRACING_SAMPLE_RATIO"), 64)
+	if ratio <= 0 {
+		ratio = 1
+	}
+	instanceId, _ := os.Hostname()
+
+	return tracing.Config{
+		Enable:        strings.ToLower(os.Getenv("TRACING_ENABLE")) == "true",
+		Address:       os.Getenv("TRACING_ADDRESS"),
+		Protocol:      os.Getenv("TRACING_PROTOCOL"),
+		Environment:   os.Getenv("APP_ENV"),
+		ModuleName:    moduleName,
+		ModuleVersion: moduleVersion,
+		InstanceId:    instanceId,
+		Sampler: tracing.SamplerConfig{
+			Type:  "traceid_ratio",
+			Ratio: ratio,
+		},
+	}
+}
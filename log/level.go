@@ -0,0 +1,25 @@
+package log
+
+import "github.com/pkg/errors"
+
+// LevelSetter is implemented by loggers whose minimum level can be changed at runtime, e.g. Adapter, so
+// operators get a live dial for debugging noisy services without redeploying.
+type LevelSetter interface {
+	SetLevel(level Level)
+}
+
+// ParseLevel parses one of "debug", "info", "warn", "error" into a Level.
+func ParseLevel(level string) (Level, error) {
+	switch level {
+	case "debug":
+		return DebugLevel, nil
+	case "info":
+		return InfoLevel, nil
+	case "warn":
+		return WarnLevel, nil
+	case "error":
+		return ErrorLevel, nil
+	default:
+		return 0, errors.Errorf("unknown log level: %s", level)
+	}
+}
@@ -0,0 +1,94 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+)
+
+// SlogHandler adapts an *Adapter to the standard library's slog.Handler, so third-party libraries that
+// accept a *slog.Logger (HTTP clients, database drivers, the OTel SDK, etc.) emit into the kit's logging
+// pipeline with the same rotation, level and field conventions configured in app.New.
+type SlogHandler struct {
+	adapter *Adapter
+	fields  []Field
+	group   string
+}
+
+// NewSlogHandler returns a slog.Handler backed by a.
+func NewSlogHandler(a *Adapter) slog.Handler {
+	return &SlogHandler{adapter: a}
+}
+
+func (h *SlogHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *SlogHandler) Handle(ctx context.Context, record slog.Record) error {
+	fields := make([]Field, 0, len(h.fields)+record.NumAttrs())
+	fields = append(fields, h.fields...)
+	record.Attrs(func(attr slog.Attr) bool {
+		fields = append(fields, h.attrFields(attr)...)
+		return true
+	})
+
+	switch {
+	case record.Level >= slog.LevelError:
+		h.adapter.Error(ctx, errors.New(record.Message), fields...)
+	case record.Level >= slog.LevelWarn:
+		h.adapter.Warn(ctx, record.Message, fields...)
+	case record.Level >= slog.LevelInfo:
+		h.adapter.Info(ctx, record.Message, fields...)
+	default:
+		h.adapter.Debug(ctx, record.Message, fields...)
+	}
+	return nil
+}
+
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	fields := make([]Field, 0, len(h.fields)+len(attrs))
+	fields = append(fields, h.fields...)
+	for _, attr := range attrs {
+		fields = append(fields, h.attrFields(attr)...)
+	}
+	return &SlogHandler{adapter: h.adapter, fields: fields, group: h.group}
+}
+
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	if h.group != "" {
+		name = h.group + "." + name
+	}
+	return &SlogHandler{adapter: h.adapter, fields: h.fields, group: name}
+}
+
+// attrFields flattens attr into one or more Fields, prefixing keys with the handler's current group (set
+// via WithGroup). A group-kind attr (including one created with slog.Group) is recursively flattened
+// into dotted keys instead of becoming a single Field holding a raw []slog.Attr.
+func (h *SlogHandler) attrFields(attr slog.Attr) []Field {
+	key := attr.Key
+	if h.group != "" {
+		key = h.group + "." + key
+	}
+	return flattenAttr(key, attr)
+}
+
+func flattenAttr(key string, attr slog.Attr) []Field {
+	value := attr.Value.Resolve()
+	if value.Kind() != slog.KindGroup {
+		return []Field{Any(key, value.Any())}
+	}
+
+	group := value.Group()
+	fields := make([]Field, 0, len(group))
+	for _, sub := range group {
+		fields = append(fields, flattenAttr(key+"."+sub.Key, sub)...)
+	}
+	return fields
+}
+
+// Any builds a Field from an arbitrary value, for call sites (like SlogHandler) that don't know the
+// concrete type of the value ahead of time.
+func Any(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
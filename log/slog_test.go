@@ -0,0 +1,85 @@
+package log
+
+import (
+	"log/slog"
+	"testing"
+)
+
+func TestFlattenAttr_Scalar(t *testing.T) {
+	fields := flattenAttr("count", slog.Int("count", 3))
+	if len(fields) != 1 || fields[0].Key != "count" || fields[0].Value != int64(3) {
+		t.Fatalf("unexpected fields: %+v", fields)
+	}
+}
+
+func TestFlattenAttr_GroupIsFlattenedIntoDottedKeys(t *testing.T) {
+	attr := slog.Group("request",
+		slog.String("method", "GET"),
+		slog.Int("status", 200),
+	)
+
+	fields := flattenAttr("request", attr)
+
+	want := map[string]interface{}{
+		"request.method": "GET",
+		"request.status": int64(200),
+	}
+	if len(fields) != len(want) {
+		t.Fatalf("got %d fields, want %d: %+v", len(fields), len(want), fields)
+	}
+	for _, f := range fields {
+		wantValue, ok := want[f.Key]
+		if !ok {
+			t.Fatalf("unexpected field key %q", f.Key)
+		}
+		if f.Value != wantValue {
+			t.Fatalf("field %q = %v, want %v", f.Key, f.Value, wantValue)
+		}
+	}
+}
+
+func TestFlattenAttr_NestedGroup(t *testing.T) {
+	attr := slog.Group("http",
+		slog.Group("request",
+			slog.String("method", "POST"),
+		),
+	)
+
+	fields := flattenAttr("http", attr)
+
+	if len(fields) != 1 || fields[0].Key != "http.request.method" || fields[0].Value != "POST" {
+		t.Fatalf("unexpected fields: %+v", fields)
+	}
+}
+
+func TestSlogHandler_WithGroupPrefixesAttrKeys(t *testing.T) {
+	h := &SlogHandler{}
+	grouped := h.WithGroup("request").(*SlogHandler)
+
+	fields := grouped.attrFields(slog.String("method", "GET"))
+
+	if len(fields) != 1 || fields[0].Key != "request.method" {
+		t.Fatalf("unexpected fields: %+v", fields)
+	}
+}
+
+func TestSlogHandler_WithGroupNesting(t *testing.T) {
+	h := &SlogHandler{}
+	grouped := h.WithGroup("http").(*SlogHandler).WithGroup("request").(*SlogHandler)
+
+	fields := grouped.attrFields(slog.String("method", "GET"))
+
+	if len(fields) != 1 || fields[0].Key != "http.request.method" {
+		t.Fatalf("unexpected fields: %+v", fields)
+	}
+}
+
+func TestSlogHandler_WithAttrsAccumulates(t *testing.T) {
+	h := &SlogHandler{}
+	h2 := h.WithAttrs([]slog.Attr{slog.String("a", "1")}).(*SlogHandler)
+	h3 := h2.WithAttrs([]slog.Attr{slog.String("b", "2")}).(*SlogHandler)
+
+	if len(h3.fields) != 2 {
+		t.Fatalf("expected accumulated fields from both WithAttrs calls, got %+v", h3.fields)
+	}
+}
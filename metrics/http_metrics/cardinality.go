@@ -0,0 +1,67 @@
+package http_metrics
+
+import (
+	"sync"
+	"time"
+)
+
+const otherPathLabel = "__other__"
+
+// CardinalityLimiter wraps a *ServerStorage and collapses the `path` label into "__other__" once more
+// than Limit distinct paths have been observed, so a handler that echoes unbounded path segments (ids,
+// slugs, ...) back as the label can't blow up metrics cardinality.
+type CardinalityLimiter struct {
+	storage *ServerStorage
+
+	mu    sync.Mutex
+	limit int
+	seen  map[string]struct{}
+}
+
+// NewCardinalityLimiter wraps storage with an initial limit; SetLimit can adjust it at runtime, e.g.
+// from a remote-config watcher.
+func NewCardinalityLimiter(storage *ServerStorage, limit int) *CardinalityLimiter {
+	return &CardinalityLimiter{
+		storage: storage,
+		limit:   limit,
+		seen:    make(map[string]struct{}),
+	}
+}
+
+// SetLimit atomically changes the cardinality limit. Paths already counted under the old limit keep
+// their own label; only newly-seen paths beyond the new limit are collapsed.
+func (l *CardinalityLimiter) SetLimit(limit int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limit = limit
+}
+
+func (l *CardinalityLimiter) resolvePath(path string) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.seen[path]; ok {
+		return path
+	}
+	if l.limit > 0 && len(l.seen) >= l.limit {
+		return otherPathLabel
+	}
+	l.seen[path] = struct{}{}
+	return path
+}
+
+func (l *CardinalityLimiter) ObserveDuration(method string, path string, duration time.Duration) {
+	l.storage.ObserveDuration(method, l.resolvePath(path), duration)
+}
+
+func (l *CardinalityLimiter) ObserveRequestBodySize(method string, path string, size int) {
+	l.storage.ObserveRequestBodySize(method, l.resolvePath(path), size)
+}
+
+func (l *CardinalityLimiter) ObserveResponseBodySize(method string, path string, size int) {
+	l.storage.ObserveResponseBodySize(method, l.resolvePath(path), size)
+}
+
+func (l *CardinalityLimiter) CountStatusCode(method string, path string, code int) {
+	l.storage.CountStatusCode(method, l.resolvePath(path), code)
+}
@@ -0,0 +1,44 @@
+package http_metrics
+
+import "testing"
+
+func TestCardinalityLimiter_ResolvePath(t *testing.T) {
+	l := NewCardinalityLimiter(nil, 2)
+
+	if got := l.resolvePath("/a"); got != "/a" {
+		t.Fatalf("first path = %q, want %q", got, "/a")
+	}
+	if got := l.resolvePath("/b"); got != "/b" {
+		t.Fatalf("second path = %q, want %q", got, "/b")
+	}
+	if got := l.resolvePath("/c"); got != otherPathLabel {
+		t.Fatalf("third path over the limit = %q, want %q", got, otherPathLabel)
+	}
+	if got := l.resolvePath("/a"); got != "/a" {
+		t.Fatalf("already-seen path should keep its own label, got %q", got)
+	}
+}
+
+func TestCardinalityLimiter_ZeroLimitMeansUnbounded(t *testing.T) {
+	l := NewCardinalityLimiter(nil, 0)
+
+	for i, path := range []string{"/a", "/b", "/c"} {
+		if got := l.resolvePath(path); got != path {
+			t.Fatalf("path %d: got %q, want %q", i, got, path)
+		}
+	}
+}
+
+func TestCardinalityLimiter_SetLimit(t *testing.T) {
+	l := NewCardinalityLimiter(nil, 1)
+
+	l.resolvePath("/a")
+	if got := l.resolvePath("/b"); got != otherPathLabel {
+		t.Fatalf("path beyond initial limit = %q, want %q", got, otherPathLabel)
+	}
+
+	l.SetLimit(2)
+	if got := l.resolvePath("/b"); got != "/b" {
+		t.Fatalf("path within the raised limit = %q, want %q", got, "/b")
+	}
+}
@@ -8,11 +8,25 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// NativeHistogramBucketFactor, NativeHistogramMaxBucketNumber and NativeHistogramMinResetDuration are the
+// defaults used by NewServerStorageWithHistograms. Values are taken from Prometheus' own recommendations
+// for sparse native histograms.
+const (
+	NativeHistogramBucketFactor     = 1.1
+	NativeHistogramMaxBucketNumber  = 100
+	NativeHistogramMinResetDuration = time.Hour
+)
+
 type ServerStorage struct {
 	duration         *prometheus.SummaryVec
 	requestBodySize  *prometheus.SummaryVec
 	responseBodySize *prometheus.SummaryVec
 	statusCounter    *prometheus.CounterVec
+
+	durationHistogram         *prometheus.HistogramVec
+	requestBodySizeHistogram  *prometheus.HistogramVec
+	responseBodySizeHistogram *prometheus.HistogramVec
+	histograms                bool
 }
 
 func NewServerStorage(reg *metrics.Registry) *ServerStorage {
@@ -45,15 +59,71 @@ func NewServerStorage(reg *metrics.Registry) *ServerStorage {
 	return s
 }
 
+// NewServerStorageWithHistograms is an opt-in alternative to NewServerStorage that records request
+// duration and body size as Prometheus native (sparse) histograms instead of summaries, so distributions
+// can be aggregated across instances. If reg's registry doesn't negotiate native histogram exposition,
+// prometheus/client_golang falls back to the classic exponential buckets configured below.
+func NewServerStorageWithHistograms(reg *metrics.Registry) *ServerStorage {
+	s := &ServerStorage{
+		durationHistogram: metrics.GetOrRegister(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem:                       "http",
+			Name:                            "request_duration_ms",
+			Help:                            "The latency of the HTTP requests",
+			Buckets:                         prometheus.ExponentialBuckets(1, 2, 16),
+			NativeHistogramBucketFactor:     NativeHistogramBucketFactor,
+			NativeHistogramMaxBucketNumber:  NativeHistogramMaxBucketNumber,
+			NativeHistogramMinResetDuration: NativeHistogramMinResetDuration,
+		}, []string{"method", "path"})),
+		requestBodySizeHistogram: metrics.GetOrRegister(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem:                       "http",
+			Name:                            "request_body_size",
+			Help:                            "The size of request body",
+			Buckets:                         prometheus.ExponentialBuckets(64, 2, 16),
+			NativeHistogramBucketFactor:     NativeHistogramBucketFactor,
+			NativeHistogramMaxBucketNumber:  NativeHistogramMaxBucketNumber,
+			NativeHistogramMinResetDuration: NativeHistogramMinResetDuration,
+		}, []string{"method", "path"})),
+		responseBodySizeHistogram: metrics.GetOrRegister(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem:                       "http",
+			Name:                            "response_body_size",
+			Help:                            "The size of response body",
+			Buckets:                         prometheus.ExponentialBuckets(64, 2, 16),
+			NativeHistogramBucketFactor:     NativeHistogramBucketFactor,
+			NativeHistogramMaxBucketNumber:  NativeHistogramMaxBucketNumber,
+			NativeHistogramMinResetDuration: NativeHistogramMinResetDuration,
+		}, []string{"method", "path"})),
+		statusCounter: metrics.GetOrRegister(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem:   "http",
+			Name:        "status_code_count",
+			Help:        "Counter of statuses codes",
+			ConstLabels: nil,
+		}, []string{"method", "path", "code"})),
+		histograms: true,
+	}
+	return s
+}
+
 func (s *ServerStorage) ObserveDuration(method string, path string, duration time.Duration) {
+	if s.histograms {
+		s.durationHistogram.WithLabelValues(method, path).Observe(float64(duration.Milliseconds()))
+		return
+	}
 	s.duration.WithLabelValues(method, path).Observe(float64(duration.Milliseconds()))
 }
 
 func (s *ServerStorage) ObserveRequestBodySize(method string, path string, size int) {
+	if s.histograms {
+		s.requestBodySizeHistogram.WithLabelValues(method, path).Observe(float64(size))
+		return
+	}
 	s.requestBodySize.WithLabelValues(method, path).Observe(float64(size))
 }
 
 func (s *ServerStorage) ObserveResponseBodySize(method string, path string, size int) {
+	if s.histograms {
+		s.responseBodySizeHistogram.WithLabelValues(method, path).Observe(float64(size))
+		return
+	}
 	s.responseBodySize.WithLabelValues(method, path).Observe(float64(size))
 }
 
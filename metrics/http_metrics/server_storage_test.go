@@ -0,0 +1,50 @@
+package http_metrics
+
+import (
+	"testing"
+
+	"github.com/integration-system/isp-kit/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestNewServerStorage_ObservesSummaries(t *testing.T) {
+	s := NewServerStorage(metrics.NewRegistry())
+	if s.histograms {
+		t.Fatalf("NewServerStorage must not opt into histograms")
+	}
+
+	s.ObserveDuration("GET", "/a", 0)
+	s.ObserveRequestBodySize("GET", "/a", 0)
+	s.ObserveResponseBodySize("GET", "/a", 0)
+
+	if got := testutil.CollectAndCount(s.duration); got != 1 {
+		t.Fatalf("duration summary observations = %d, want 1", got)
+	}
+	if got := testutil.CollectAndCount(s.requestBodySize); got != 1 {
+		t.Fatalf("requestBodySize summary observations = %d, want 1", got)
+	}
+	if got := testutil.CollectAndCount(s.responseBodySize); got != 1 {
+		t.Fatalf("responseBodySize summary observations = %d, want 1", got)
+	}
+}
+
+func TestNewServerStorageWithHistograms_ObservesHistograms(t *testing.T) {
+	s := NewServerStorageWithHistograms(metrics.NewRegistry())
+	if !s.histograms {
+		t.Fatalf("NewServerStorageWithHistograms must opt into histograms")
+	}
+
+	s.ObserveDuration("GET", "/a", 0)
+	s.ObserveRequestBodySize("GET", "/a", 0)
+	s.ObserveResponseBodySize("GET", "/a", 0)
+
+	if got := testutil.CollectAndCount(s.durationHistogram); got != 1 {
+		t.Fatalf("durationHistogram observations = %d, want 1", got)
+	}
+	if got := testutil.CollectAndCount(s.requestBodySizeHistogram); got != 1 {
+		t.Fatalf("requestBodySizeHistogram observations = %d, want 1", got)
+	}
+	if got := testutil.CollectAndCount(s.responseBodySizeHistogram); got != 1 {
+		t.Fatalf("responseBodySizeHistogram observations = %d, want 1", got)
+	}
+}
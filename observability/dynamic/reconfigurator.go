@@ -0,0 +1,86 @@
+// Package dynamic watches the `observability` remote-config block and reconfigures the kit's own
+// observability stack - log level, trace sampler, HTTP metrics cardinality limit - at runtime, so
+// operators get a live dial for debugging noisy services without redeploying.
+package dynamic
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/integration-system/isp-kit/log"
+	"github.com/integration-system/isp-kit/metrics/http_metrics"
+	"github.com/integration-system/isp-kit/observability/tracing"
+	"github.com/integration-system/isp-kit/rc"
+	"github.com/pkg/errors"
+)
+
+// Config is the well-known `observability` remote-config block. An empty/zero field leaves the
+// corresponding setting untouched, so a partial update doesn't reset settings operators haven't touched.
+type Config struct {
+	LogLevel                        string  `json:"logLevel"`
+	TraceSampleRatio                float64 `json:"traceSampleRatio"`
+	HttpMetricsPathCardinalityLimit int     `json:"httpMetricsPathCardinalityLimit"`
+}
+
+// remoteConfigBlock extracts the `observability` key out of a module's remote config document, so the
+// block can be watched regardless of the module's own config schema.
+type remoteConfigBlock struct {
+	Observability Config `json:"observability"`
+}
+
+// Reconfigurator applies Config changes to the live log level, trace sampler and HTTP metrics
+// cardinality limiter. sampler and limiter may be nil when that subsystem isn't wired up; the
+// corresponding Config field is then ignored instead of panicking.
+type Reconfigurator struct {
+	logger   *log.Adapter
+	logLevel log.LevelSetter
+	sampler  *tracing.MutableSampler
+	limiter  *http_metrics.CardinalityLimiter
+}
+
+func New(logger *log.Adapter, logLevel log.LevelSetter, sampler *tracing.MutableSampler, limiter *http_metrics.CardinalityLimiter) *Reconfigurator {
+	return &Reconfigurator{
+		logger:   logger,
+		logLevel: logLevel,
+		sampler:  sampler,
+		limiter:  limiter,
+	}
+}
+
+// Apply atomically swaps in the settings described by cfg.
+func (r *Reconfigurator) Apply(ctx context.Context, cfg Config) {
+	if cfg.LogLevel != "" {
+		level, err := log.ParseLevel(cfg.LogLevel)
+		if err != nil {
+			r.logger.Error(ctx, err)
+		} else {
+			r.logLevel.SetLevel(level)
+		}
+	}
+	if cfg.TraceSampleRatio > 0 && r.sampler != nil {
+		r.sampler.SetRatio(cfg.TraceSampleRatio)
+	}
+	if cfg.HttpMetricsPathCardinalityLimit > 0 && r.limiter != nil {
+		r.limiter.SetLimit(cfg.HttpMetricsPathCardinalityLimit)
+	}
+}
+
+// WatchRemoteConfig subscribes to remoteConfig's revalidation path and applies the `observability` block
+// of every successfully-upserted config document to r, until ctx is done. It returns immediately; the
+// subscription is torn down in the background once ctx is canceled.
+func (r *Reconfigurator) WatchRemoteConfig(ctx context.Context, remoteConfig *rc.Config) {
+	unsubscribe := remoteConfig.Subscribe(func(data []byte) {
+		block := remoteConfigBlock{}
+		err := json.Unmarshal(data, &block)
+		if err != nil {
+			r.logger.Error(ctx, errors.WithMessage(err, "unmarshal observability block"))
+			return
+		}
+		r.Apply(ctx, block.Observability)
+	})
+
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+	}()
+}
@@ -0,0 +1,90 @@
+package dynamic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/integration-system/isp-kit/log"
+	"github.com/integration-system/isp-kit/metrics/http_metrics"
+	"github.com/integration-system/isp-kit/observability/tracing"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type fakeLevelSetter struct {
+	level log.Level
+}
+
+func (f *fakeLevelSetter) SetLevel(level log.Level) {
+	f.level = level
+}
+
+func newTestLogger(t *testing.T) *log.Adapter {
+	t.Helper()
+	logger, err := log.New(log.WithDevelopmentMode())
+	if err != nil {
+		t.Fatalf("new logger: %v", err)
+	}
+	return logger
+}
+
+func TestReconfigurator_Apply_LogLevel(t *testing.T) {
+	setter := &fakeLevelSetter{level: log.DebugLevel}
+	r := New(newTestLogger(t), setter, nil, nil)
+
+	r.Apply(context.Background(), Config{LogLevel: "warn"})
+
+	if setter.level != log.WarnLevel {
+		t.Fatalf("level = %v, want WarnLevel", setter.level)
+	}
+}
+
+func TestReconfigurator_Apply_InvalidLogLevelLeavesSetterUntouched(t *testing.T) {
+	setter := &fakeLevelSetter{level: log.DebugLevel}
+	r := New(newTestLogger(t), setter, nil, nil)
+
+	r.Apply(context.Background(), Config{LogLevel: "bogus"})
+
+	if setter.level != log.DebugLevel {
+		t.Fatalf("an invalid level must not change the setter, got %v", setter.level)
+	}
+}
+
+func TestReconfigurator_Apply_EmptyConfigIsANoop(t *testing.T) {
+	setter := &fakeLevelSetter{level: log.InfoLevel}
+	r := New(newTestLogger(t), setter, nil, nil)
+
+	r.Apply(context.Background(), Config{})
+
+	if setter.level != log.InfoLevel {
+		t.Fatalf("an empty Config must leave the level untouched, got %v", setter.level)
+	}
+}
+
+func TestReconfigurator_Apply_NilSamplerAndLimiterDontPanic(t *testing.T) {
+	r := New(newTestLogger(t), &fakeLevelSetter{}, nil, nil)
+
+	r.Apply(context.Background(), Config{TraceSampleRatio: 0.5, HttpMetricsPathCardinalityLimit: 10})
+}
+
+func TestReconfigurator_Apply_TraceSampleRatioAndCardinalityLimit(t *testing.T) {
+	sampler := tracing.NewMutableSampler(0)
+	limiter := http_metrics.NewCardinalityLimiter(nil, 1)
+	r := New(newTestLogger(t), &fakeLevelSetter{}, sampler, limiter)
+
+	r.Apply(context.Background(), Config{TraceSampleRatio: 1, HttpMetricsPathCardinalityLimit: 2})
+
+	// Neither type has an accessor for its setting. The sampler's new ratio shows in its ShouldSample
+	// decision; the limiter's only shows through a ServerStorage, so for it Apply not panicking is checked.
+	result := sampler.ShouldSample(sdktrace.SamplingParameters{
+		ParentContext: context.Background(),
+		TraceID:       trace.TraceID{1},
+		Name:          "test",
+	})
+	if result.Decision != sdktrace.RecordAndSample {
+		t.Fatalf("ratio 1 after Apply: decision = %v, want RecordAndSample", result.Decision)
+	}
+	if sampler.Description() != "MutableSampler" {
+		t.Fatalf("unexpected sampler description: %s", sampler.Description())
+	}
+}
@@ -0,0 +1,31 @@
+package tracing
+
+import "context"
+
+// Closer adapts a Provider's Shutdown to app.Closer's Close() error and app.ContextCloser's
+// CloseContext(ctx context.Context) error, so it can be registered via app.Application.AddClosers and
+// flushed cleanly on Application.Shutdown() instead of being dropped when the batcher goroutine is killed.
+type Closer struct {
+	provider Provider
+}
+
+// NewCloser wraps provider. Both the OTLP-backed provider built by NewProviderFromConfiguration and
+// NewNoopProvider implement Shutdown(ctx context.Context) error; providers that don't are left unclosed.
+func NewCloser(provider Provider) *Closer {
+	return &Closer{provider: provider}
+}
+
+// Close shuts the provider down with no deadline; Application.Shutdown calls CloseContext instead.
+func (c *Closer) Close() error {
+	return c.CloseContext(context.Background())
+}
+
+func (c *Closer) CloseContext(ctx context.Context) error {
+	shutdowner, ok := c.provider.(interface {
+		Shutdown(ctx context.Context) error
+	})
+	if !ok {
+		return nil
+	}
+	return shutdowner.Shutdown(ctx)
+}
@@ -0,0 +1,39 @@
+package tracing
+
+// Config configures the OTLP exporter built by NewProviderFromConfiguration.
+type Config struct {
+	Enable        bool
+	Address       string
+	Environment   string
+	ModuleName    string
+	ModuleVersion string
+	InstanceId    string
+	Attributes    map[string]string
+
+	// Protocol selects the OTLP transport: "http" (default) or "grpc".
+	Protocol    string
+	TLS         *TLSConfig
+	Headers     map[string]string
+	Compression string
+
+	Sampler SamplerConfig
+}
+
+// TLSConfig configures client TLS for the OTLP exporter. A nil *TLSConfig means plaintext for the
+// "http" protocol and the system cert pool for "grpc".
+type TLSConfig struct {
+	CAFile             string
+	CertFile           string
+	KeyFile            string
+	InsecureSkipVerify bool
+}
+
+// SamplerConfig describes how to build an sdktrace.Sampler. Type is one of "always" (default), "never",
+// "traceid_ratio" or "parent_based".
+type SamplerConfig struct {
+	Type string
+	// Ratio is used when Type is "traceid_ratio".
+	Ratio float64
+	// Parent is the root sampler wrapped by sdktrace.ParentBased when Type is "parent_based".
+	Parent *SamplerConfig
+}
@@ -0,0 +1,122 @@
+package tracing
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+func newExporter(ctx context.Context, config Config) (*otlptrace.Exporter, error) {
+	switch config.Protocol {
+	case "grpc":
+		return newGrpcExporter(ctx, config)
+	case "", "http":
+		return newHttpExporter(ctx, config)
+	default:
+		return nil, errors.Errorf("unknown protocol: %s", config.Protocol)
+	}
+}
+
+func newHttpExporter(ctx context.Context, config Config) (*otlptrace.Exporter, error) {
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(config.Address),
+	}
+	if len(config.Headers) > 0 {
+		opts = append(opts, otlptracehttp.WithHeaders(config.Headers))
+	}
+	if config.Compression == "gzip" {
+		opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+	}
+	tlsConfig, err := newTlsConfig(config.TLS)
+	if err != nil {
+		return nil, errors.WithMessage(err, "new tls config")
+	}
+	if tlsConfig != nil {
+		opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsConfig))
+	} else {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, errors.WithMessage(err, "new otlp http exporter")
+	}
+	return exporter, nil
+}
+
+func newGrpcExporter(ctx context.Context, config Config) (*otlptrace.Exporter, error) {
+	tlsConfig, err := newTlsConfig(config.TLS)
+	if err != nil {
+		return nil, errors.WithMessage(err, "new tls config")
+	}
+	if tlsConfig == nil {
+		// A nil *TLSConfig means the system cert pool for grpc (unlike http, which defaults to
+		// plaintext) - the OTLP grpc exporter almost always talks to a collector over TLS, so the safe
+		// default is to verify it rather than to silently downgrade to plaintext.
+		tlsConfig = &tls.Config{}
+	}
+	transportCreds := credentials.NewTLS(tlsConfig)
+
+	conn, err := grpc.NewClient(
+		config.Address,
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return nil, errors.WithMessage(err, "dial otlp grpc endpoint")
+	}
+
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithGRPCConn(conn),
+	}
+	if len(config.Headers) > 0 {
+		opts = append(opts, otlptracegrpc.WithHeaders(config.Headers))
+	}
+	if config.Compression == "gzip" {
+		opts = append(opts, otlptracegrpc.WithCompressor("gzip"))
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, errors.WithMessage(err, "new otlp grpc exporter")
+	}
+	return exporter, nil
+}
+
+func newTlsConfig(config *TLSConfig) (*tls.Config, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	if config.CertFile != "" && config.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, errors.WithMessage(err, "load x509 key pair")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if config.CAFile != "" {
+		caCert, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, errors.WithMessage(err, "read ca file")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("append ca cert to pool")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
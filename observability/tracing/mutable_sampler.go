@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// MutableSampler is an sdktrace.Sampler whose ratio can be swapped at runtime, e.g. from a remote-config
+// watcher, without rebuilding the TracerProvider.
+type MutableSampler struct {
+	sampler atomic.Pointer[sdktrace.Sampler]
+}
+
+// NewMutableSampler wraps an initial traceid_ratio sampler with the given ratio.
+func NewMutableSampler(ratio float64) *MutableSampler {
+	m := &MutableSampler{}
+	m.SetRatio(ratio)
+	return m
+}
+
+// NewMutableSamplerFrom wraps an already-built sampler of any kind (traceid_ratio, parent_based, ...) so
+// it can later be swapped out, e.g. by SetRatio, without rebuilding the TracerProvider.
+func NewMutableSamplerFrom(sampler sdktrace.Sampler) *MutableSampler {
+	m := &MutableSampler{}
+	m.sampler.Store(&sampler)
+	return m
+}
+
+// SetRatio atomically swaps the underlying sampler for a new traceid_ratio one.
+func (m *MutableSampler) SetRatio(ratio float64) {
+	sampler := sdktrace.TraceIDRatioBased(ratio)
+	m.sampler.Store(&sampler)
+}
+
+func (m *MutableSampler) ShouldSample(parameters sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return (*m.sampler.Load()).ShouldSample(parameters)
+}
+
+func (m *MutableSampler) Description() string {
+	return "MutableSampler"
+}
@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func sample(t *testing.T, sampler sdktrace.Sampler) sdktrace.SamplingDecision {
+	t.Helper()
+	result := sampler.ShouldSample(sdktrace.SamplingParameters{
+		ParentContext: context.Background(),
+		TraceID:       trace.TraceID{1},
+		Name:          "test",
+	})
+	return result.Decision
+}
+
+func TestMutableSampler_SetRatio(t *testing.T) {
+	m := NewMutableSampler(0)
+	if decision := sample(t, m); decision != sdktrace.Drop {
+		t.Fatalf("ratio 0: decision = %v, want Drop", decision)
+	}
+
+	m.SetRatio(1)
+	if decision := sample(t, m); decision != sdktrace.RecordAndSample {
+		t.Fatalf("ratio 1 after SetRatio: decision = %v, want RecordAndSample", decision)
+	}
+}
+
+func TestNewMutableSamplerFrom(t *testing.T) {
+	m := NewMutableSamplerFrom(sdktrace.AlwaysSample())
+	if decision := sample(t, m); decision != sdktrace.RecordAndSample {
+		t.Fatalf("AlwaysSample wrapped: decision = %v, want RecordAndSample", decision)
+	}
+
+	m.SetRatio(0)
+	if decision := sample(t, m); decision != sdktrace.Drop {
+		t.Fatalf("after SetRatio(0): decision = %v, want Drop", decision)
+	}
+}
+
+func TestMutableSampler_Description(t *testing.T) {
+	m := NewMutableSampler(1)
+	if got := m.Description(); got != "MutableSampler" {
+		t.Fatalf("Description() = %q, want %q", got, "MutableSampler")
+	}
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -21,18 +20,25 @@ const (
 	RequestId = attribute.Key("request_id")
 )
 
-func NewProviderFromConfiguration(ctx context.Context, config Config) (Provider, error) {
+// NewProviderFromConfiguration builds a Provider and the MutableSampler backing its sampling decisions, so
+// a caller (e.g. observability/dynamic.Reconfigurator) can adjust the sample ratio at runtime without
+// rebuilding the provider. sampler is never nil, even when config.Enable is false: adjusting it then is
+// simply a no-op, since NewNoopProvider ignores sampling altogether.
+func NewProviderFromConfiguration(ctx context.Context, config Config) (provider Provider, sampler *MutableSampler, err error) {
 	if !config.Enable {
-		return NewNoopProvider(), nil
+		return NewNoopProvider(), NewMutableSampler(config.Sampler.Ratio), nil
 	}
 
-	exporter, err := otlptracehttp.New(
-		ctx,
-		otlptracehttp.WithEndpoint(config.Address),
-	)
+	exporter, err := newExporter(ctx, config)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "new otlp exporter")
+	}
+
+	baseSampler, err := newSampler(config.Sampler)
 	if err != nil {
-		return nil, errors.WithMessage(err, "new otlp http exporter")
+		return nil, nil, errors.WithMessage(err, "new sampler")
 	}
+	sampler = NewMutableSamplerFrom(baseSampler)
 
 	attributes := []attribute.KeyValue{
 		semconv.DeploymentEnvironment(config.Environment),
@@ -51,13 +57,13 @@ func NewProviderFromConfiguration(ctx context.Context, config Config) (Provider,
 		),
 	)
 	if err != nil {
-		return nil, errors.WithMessage(err, "new resource")
+		return nil, nil, errors.WithMessage(err, "new resource")
 	}
 
-	provider := sdktrace.NewTracerProvider(
+	provider = sdktrace.NewTracerProvider(
 		sdktrace.WithBatcher(exporter),
 		sdktrace.WithResource(res),
-		sdktrace.WithSampler(sdktrace.AlwaysSample()), //TODO consider configuration, but pass all for now
+		sdktrace.WithSampler(sampler),
 	)
-	return provider, nil
+	return provider, sampler, nil
 }
@@ -0,0 +1,28 @@
+package tracing
+
+import (
+	"github.com/pkg/errors"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func newSampler(cfg SamplerConfig) (sdktrace.Sampler, error) {
+	switch cfg.Type {
+	case "", "always":
+		return sdktrace.AlwaysSample(), nil
+	case "never":
+		return sdktrace.NeverSample(), nil
+	case "traceid_ratio":
+		return sdktrace.TraceIDRatioBased(cfg.Ratio), nil
+	case "parent_based":
+		if cfg.Parent == nil {
+			return nil, errors.New("parent_based sampler requires a root sampler")
+		}
+		root, err := newSampler(*cfg.Parent)
+		if err != nil {
+			return nil, errors.WithMessage(err, "new root sampler")
+		}
+		return sdktrace.ParentBased(root), nil
+	default:
+		return nil, errors.Errorf("unknown sampler type: %s", cfg.Type)
+	}
+}
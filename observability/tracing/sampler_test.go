@@ -0,0 +1,49 @@
+package tracing
+
+import "testing"
+
+func TestNewSampler(t *testing.T) {
+	cases := []struct {
+		name string
+		cfg  SamplerConfig
+	}{
+		{"default", SamplerConfig{}},
+		{"always", SamplerConfig{Type: "always"}},
+		{"never", SamplerConfig{Type: "never"}},
+		{"traceid_ratio", SamplerConfig{Type: "traceid_ratio", Ratio: 0.5}},
+		{"parent_based", SamplerConfig{Type: "parent_based", Parent: &SamplerConfig{Type: "always"}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			sampler, err := newSampler(tc.cfg)
+			if err != nil {
+				t.Fatalf("newSampler(%+v): %v", tc.cfg, err)
+			}
+			if sampler == nil {
+				t.Fatal("newSampler returned a nil sampler without an error")
+			}
+		})
+	}
+}
+
+func TestNewSampler_ParentBasedWithoutParent(t *testing.T) {
+	_, err := newSampler(SamplerConfig{Type: "parent_based"})
+	if err == nil {
+		t.Fatal("expected an error when parent_based has no root sampler")
+	}
+}
+
+func TestNewSampler_ParentBasedPropagatesRootError(t *testing.T) {
+	_, err := newSampler(SamplerConfig{Type: "parent_based", Parent: &SamplerConfig{Type: "bogus"}})
+	if err == nil {
+		t.Fatal("expected the root sampler's error to propagate")
+	}
+}
+
+func TestNewSampler_UnknownType(t *testing.T) {
+	_, err := newSampler(SamplerConfig{Type: "bogus"})
+	if err == nil {
+		t.Fatal("expected an error for an unknown sampler type")
+	}
+}